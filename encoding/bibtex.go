@@ -0,0 +1,61 @@
+// File:	ebooks/relname/encoding/bibtex.go
+
+package encoding
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/c12h/relname"
+)
+
+// bibtexFormatter strips prefixes and post-nominals from the names going
+// into a BibTeX field: BibTeX's "Surname, Forename" syntax has no place for
+// them, and UnmarshalBibTeX only looks for the first comma, so leaving them
+// in would silently corrupt the parse instead of just dropping the
+// decoration.
+var bibtexFormatter = relname.Formatter{OmitPrefixes: true, OmitPostnominals: true}
+
+// MarshalBibTeX renders a NameList as a BibTeX name-list field, eg.
+// MarshalBibTeX("author", nl) might return
+//
+//	author = {Niven, Larry and Pournelle, Jerry}
+//
+// using each Name's FileAs() form (without any prefixes or post-nominals —
+// see bibtexFormatter) and BibTeX's "and"-separated list syntax.
+func MarshalBibTeX(field string, nl NameList) string {
+	parts := make([]string, len(nl))
+	for i, n := range nl {
+		parts[i] = bibtexFormatter.FileAs(n)
+	}
+	return fmt.Sprintf("%s = {%s}", field, strings.Join(parts, " and "))
+}
+
+var reBibTeXField = regexp.MustCompile(`(?s)^\s*(\w+)\s*=\s*\{(.*)\}\s*,?\s*$`)
+
+// UnmarshalBibTeX parses a BibTeX name-list field (as produced by
+// MarshalBibTeX) back into the field name and a NameList.  Each name must be
+// in "Surname, Forename" form; this does not attempt to recognize particles,
+// generational suffixes, prefixes, post-nominals or BibTeX's "{Literal}"
+// group syntax.
+func UnmarshalBibTeX(s string) (field string, nl NameList, err error) {
+	m := reBibTeXField.FindStringSubmatch(s)
+	if m == nil {
+		return "", nil, fmt.Errorf("UnmarshalBibTeX(%q): not a `field = {...}` assignment", s)
+	}
+	field = m[1]
+	for _, part := range strings.Split(m[2], " and ") {
+		surname, forename, ok := strings.Cut(part, ",")
+		if !ok {
+			return "", nil, fmt.Errorf(
+				"UnmarshalBibTeX(%q): name %q is not in \"Surname, Forename\" form", s, part)
+		}
+		n, err := relname.NewName2(strings.TrimSpace(forename), strings.TrimSpace(surname))
+		if err != nil {
+			return "", nil, err
+		}
+		nl = append(nl, n)
+	}
+	return field, nl, nil
+}
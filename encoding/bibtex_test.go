@@ -0,0 +1,71 @@
+package encoding_test
+
+import (
+	"github.com/c12h/relname"
+	"github.com/c12h/relname/encoding"
+	"testing"
+)
+
+func TestBibTeXRoundTrip(t *testing.T) {
+	niven, err := relname.NewName2("Larry", "Niven")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pournelle, err := relname.NewName2("Jerry", "Pournelle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nl := encoding.NameList{niven, pournelle}
+
+	bibtex := encoding.MarshalBibTeX("author", nl)
+	expected := "author = {Niven, Larry and Pournelle, Jerry}"
+	if bibtex != expected {
+		t.Errorf("MarshalBibTeX() == %q, expected %q", bibtex, expected)
+	}
+
+	field, got, err := encoding.UnmarshalBibTeX(bibtex)
+	if err != nil {
+		t.Fatalf("UnmarshalBibTeX(%q): %v", bibtex, err)
+	}
+	if field != "author" {
+		t.Errorf("UnmarshalBibTeX field == %q, expected %q", field, "author")
+	}
+	if len(got) != 2 || got[0].Common() != "Larry Niven" || got[1].Common() != "Jerry Pournelle" {
+		t.Errorf("UnmarshalBibTeX names == %v, expected [Larry Niven, Jerry Pournelle]", got)
+	}
+}
+
+func TestBibTeXRoundTripStripsPrefixesAndPostnominals(t *testing.T) {
+	hodgell, err := relname.NewName2("P. C.", "Hodgell")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hodgell = hodgell.WithPrefixes("Dr.").WithPostnominals("Ph.D.")
+	nl := encoding.NameList{hodgell}
+
+	bibtex := encoding.MarshalBibTeX("author", nl)
+	expected := "author = {Hodgell, P. C.}"
+	if bibtex != expected {
+		t.Errorf("MarshalBibTeX() == %q, expected %q", bibtex, expected)
+	}
+
+	field, got, err := encoding.UnmarshalBibTeX(bibtex)
+	if err != nil {
+		t.Fatalf("UnmarshalBibTeX(%q): %v", bibtex, err)
+	}
+	if field != "author" {
+		t.Errorf("UnmarshalBibTeX field == %q, expected %q", field, "author")
+	}
+	if len(got) != 1 || got[0].Common() != "P. C. Hodgell" {
+		t.Errorf("UnmarshalBibTeX names == %v, expected [P. C. Hodgell]", got)
+	}
+}
+
+func TestUnmarshalBibTeXErrors(t *testing.T) {
+	if _, _, err := encoding.UnmarshalBibTeX("not bibtex at all"); err == nil {
+		t.Errorf(`UnmarshalBibTeX("not bibtex at all") should have failed`)
+	}
+	if _, _, err := encoding.UnmarshalBibTeX("author = {Larry Niven}"); err == nil {
+		t.Errorf(`UnmarshalBibTeX with no comma in a name should have failed`)
+	}
+}
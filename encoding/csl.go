@@ -0,0 +1,101 @@
+// File:	ebooks/relname/encoding/csl.go
+
+package encoding
+
+import (
+	"encoding/json"
+
+	"github.com/c12h/relname"
+)
+
+// A CSLName is the JSON shape citeproc/pandoc expect for one contributor in
+// CSL-JSON: {"family": "...", "given": "...", ...}.  See
+// https://github.com/citation-style-language/schema for the full schema;
+// this package only deals with the name-related keys.
+type CSLName struct {
+	Family              string `json:"family,omitempty"`
+	Given               string `json:"given,omitempty"`
+	Suffix              string `json:"suffix,omitempty"`
+	NonDroppingParticle string `json:"non-dropping-particle,omitempty"`
+	DroppingParticle    string `json:"dropping-particle,omitempty"`
+	Literal             string `json:"literal,omitempty"` // one-part names, eg. organizations
+}
+
+// ToCSLName converts a relname.Name to its CSL-JSON form.
+func ToCSLName(n relname.Name) CSLName {
+	if n.NumParts() <= 1 {
+		return CSLName{Literal: n.Common()}
+	}
+	c := CSLName{Given: n.Forename(), Family: n.Surname(), Suffix: n.Generation()}
+	if p := n.Particle(); p != "" {
+		if n.DroppingParticle() {
+			c.DroppingParticle = p
+		} else {
+			c.NonDroppingParticle = p
+		}
+	}
+	return c
+}
+
+// ToName converts a CSLName back to a relname.Name.
+func (c CSLName) ToName() (relname.Name, error) {
+	if c.Literal != "" {
+		return relname.NewName1(c.Literal)
+	}
+	switch {
+	case c.NonDroppingParticle != "" && c.Suffix != "":
+		n, err := relname.NewName3WithParticle(c.Given, c.NonDroppingParticle, c.Family, c.Suffix)
+		if err == nil {
+			n = n.WithDroppingParticle(false)
+		}
+		return n, err
+	case c.DroppingParticle != "" && c.Suffix != "":
+		n, err := relname.NewName3WithParticle(c.Given, c.DroppingParticle, c.Family, c.Suffix)
+		if err == nil {
+			n = n.WithDroppingParticle(true)
+		}
+		return n, err
+	case c.NonDroppingParticle != "":
+		n, err := relname.NewName2WithParticle(c.Given, c.NonDroppingParticle, c.Family)
+		if err == nil {
+			n = n.WithDroppingParticle(false)
+		}
+		return n, err
+	case c.DroppingParticle != "":
+		n, err := relname.NewName2WithParticle(c.Given, c.DroppingParticle, c.Family)
+		if err == nil {
+			n = n.WithDroppingParticle(true)
+		}
+		return n, err
+	case c.Suffix != "":
+		return relname.NewName3(c.Given, c.Family, c.Suffix)
+	default:
+		return relname.NewName2(c.Given, c.Family)
+	}
+}
+
+// MarshalCSLJSON renders a NameList as a CSL-JSON array of name objects.
+func MarshalCSLJSON(nl NameList) ([]byte, error) {
+	cslNames := make([]CSLName, len(nl))
+	for i, n := range nl {
+		cslNames[i] = ToCSLName(n)
+	}
+	return json.Marshal(cslNames)
+}
+
+// UnmarshalCSLJSON parses a CSL-JSON array of name objects into a NameList.
+func UnmarshalCSLJSON(data []byte) (NameList, error) {
+	var cslNames []CSLName
+	if err := json.Unmarshal(data, &cslNames); err != nil {
+		return nil, err
+	}
+	nl := make(NameList, len(cslNames))
+	for i, c := range cslNames {
+		n, err := c.ToName()
+		if err != nil {
+			return nil, err
+		}
+		nl[i] = n
+	}
+	return nl, nil
+}
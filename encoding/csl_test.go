@@ -0,0 +1,59 @@
+package encoding_test
+
+import (
+	"github.com/c12h/relname"
+	"github.com/c12h/relname/encoding"
+	"testing"
+)
+
+func TestCSLJSONRoundTrip(t *testing.T) {
+	dave, err := relname.NewName2("Dave", "Freer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tiptree, err := relname.NewName3("James", "Tiptree", "Jr.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	vanB, err := relname.NewName2WithParticle("Ludwig", "van", "Beethoven")
+	if err != nil {
+		t.Fatal(err)
+	}
+	vanB = vanB.WithDroppingParticle(true)
+	baen, err := relname.NewName1("Baen Books")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nl := encoding.NameList{dave, tiptree, vanB, baen}
+
+	data, err := encoding.MarshalCSLJSON(nl)
+	if err != nil {
+		t.Fatalf("MarshalCSLJSON: %v", err)
+	}
+
+	got, err := encoding.UnmarshalCSLJSON(data)
+	if err != nil {
+		t.Fatalf("UnmarshalCSLJSON(%s): %v", data, err)
+	}
+	if len(got) != len(nl) {
+		t.Fatalf("UnmarshalCSLJSON gave %d names, expected %d", len(got), len(nl))
+	}
+	for i, n := range nl {
+		if got[i].Common() != n.Common() || got[i].FileAs() != n.FileAs() {
+			t.Errorf("round-trip %d: got {%q,%q}, expected {%q,%q}",
+				i, got[i].Common(), got[i].FileAs(), n.Common(), n.FileAs())
+		}
+	}
+}
+
+func TestToCSLName(t *testing.T) {
+	deG, err := relname.NewName2WithParticle("Charles", "de", "Gaulle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := encoding.ToCSLName(deG)
+	if c.Family != "Gaulle" || c.Given != "Charles" || c.NonDroppingParticle != "de" {
+		t.Errorf("ToCSLName(deG) == %+v, expected family=Gaulle given=Charles non-dropping-particle=de", c)
+	}
+}
@@ -0,0 +1,14 @@
+// File:	ebooks/relname/encoding/encoding.go
+
+// Package “encoding” turns relname.Name and relname.RelatedName values into
+// (and back out of) the shapes that real ebook toolchains want: OPF
+// <dc:creator> elements, BibTeX author/editor fields, and CSL-JSON author
+// objects.  The last of these is what lets code using this package
+// interoperate with pandoc/citeproc pipelines.
+package encoding
+
+import "github.com/c12h/relname"
+
+// A NameList holds an ordered list of contributors, the common case for a
+// work with more than one author, editor, translator etc.
+type NameList []relname.Name
@@ -0,0 +1,69 @@
+// File:	ebooks/relname/encoding/opf.go
+
+package encoding
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/c12h/relname"
+)
+
+var xmlEscaper = strings.NewReplacer(
+	`&`, "&amp;", `<`, "&lt;", `>`, "&gt;", `"`, "&quot;", `'`, "&apos;")
+
+var xmlUnescaper = strings.NewReplacer(
+	"&amp;", `&`, "&lt;", `<`, "&gt;", `>`, "&quot;", `"`, "&apos;", `'`)
+
+// opfFormatter strips prefixes and post-nominals from the element text and
+// opf:file-as attribute: UnmarshalOPF rebuilds the Name by running the
+// element text back through relname.ParseName(), which rejects any comma, so
+// a postnominal like ", Ph.D." would make every prefixed/post-nominal Name
+// fail to round-trip instead of just losing its decoration.
+var opfFormatter = relname.Formatter{OmitPrefixes: true, OmitPostnominals: true}
+
+// MarshalOPF renders a RelatedName as an OPF/EPUB <dc:creator> (or
+// <dc:contributor>, for non-author roles) element, eg.
+//
+//	<dc:creator opf:file-as="Tiptree, James Jr." opf:role="aut">James Tiptree Jr.</dc:creator>
+//
+// The element's text and opf:file-as attribute never include prefixes or
+// post-nominals (see opfFormatter), even if rn's Name carries them.
+func MarshalOPF(rn relname.RelatedName) string {
+	element := "dc:creator"
+	if rn.Relator() != "aut" {
+		element = "dc:contributor"
+	}
+	return fmt.Sprintf(`<%s opf:file-as=%q opf:role=%q>%s</%s>`,
+		element, xmlEscaper.Replace(opfFormatter.FileAs(rn.Name)), rn.Relator(),
+		xmlEscaper.Replace(opfFormatter.Common(rn.Name)), element)
+}
+
+var reOPFElement = regexp.MustCompile(`(?s)<(dc:creator|dc:contributor)\b([^>]*)>(.*?)</(?:dc:creator|dc:contributor)>`)
+var reOPFRole = regexp.MustCompile(`opf:role="([^"]*)"`)
+
+// UnmarshalOPF parses a single OPF <dc:creator> or <dc:contributor> element
+// (as produced by MarshalOPF) back into a RelatedName.  The element's text
+// content is parsed with relname.ParseName(), so names it cannot resolve
+// (see ParseName's doc comment) will make UnmarshalOPF fail too; the
+// opf:file-as attribute is not used for reconstruction, only the element
+// text and its opf:role attribute.
+func UnmarshalOPF(elementXML string) (relname.RelatedName, error) {
+	m := reOPFElement.FindStringSubmatch(elementXML)
+	if m == nil {
+		return relname.RelatedName{}, fmt.Errorf(
+			"UnmarshalOPF(%q): not a <dc:creator> or <dc:contributor> element", elementXML)
+	}
+	attrs, text := m[2], m[3]
+	roleMatch := reOPFRole.FindStringSubmatch(attrs)
+	if roleMatch == nil {
+		return relname.RelatedName{}, fmt.Errorf(
+			"UnmarshalOPF(%q): missing opf:role attribute", elementXML)
+	}
+	n, err := relname.ParseName(xmlUnescaper.Replace(text))
+	if err != nil {
+		return relname.RelatedName{}, err
+	}
+	return relname.NewRelatedName(n, roleMatch[1])
+}
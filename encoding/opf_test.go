@@ -0,0 +1,84 @@
+package encoding_test
+
+import (
+	"github.com/c12h/relname"
+	"github.com/c12h/relname/encoding"
+	"testing"
+)
+
+func TestOPFRoundTrip(t *testing.T) {
+	tiptree, err := relname.NewName3("James", "Tiptree", "Jr.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rn, err := relname.NewRelatedName(tiptree, "aut")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	elem := encoding.MarshalOPF(rn)
+	expected := `<dc:creator opf:file-as="Tiptree, James Jr." opf:role="aut">James Tiptree Jr.</dc:creator>`
+	if elem != expected {
+		t.Errorf("MarshalOPF() == %q, expected %q", elem, expected)
+	}
+
+	got, err := encoding.UnmarshalOPF(elem)
+	if err != nil {
+		t.Fatalf("UnmarshalOPF(%q): %v", elem, err)
+	}
+	if got.Common() != rn.Common() || got.Relator() != rn.Relator() {
+		t.Errorf("UnmarshalOPF round-trip == {%q,%q}, expected {%q,%q}",
+			got.Common(), got.Relator(), rn.Common(), rn.Relator())
+	}
+}
+
+func TestOPFRoundTripStripsPrefixesAndPostnominals(t *testing.T) {
+	hodgell, err := relname.NewName2("P. C.", "Hodgell")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hodgell = hodgell.WithPrefixes("Dr.").WithPostnominals("Ph.D.")
+	rn, err := relname.NewRelatedName(hodgell, "aut")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	elem := encoding.MarshalOPF(rn)
+	expected := `<dc:creator opf:file-as="Hodgell, P. C." opf:role="aut">P. C. Hodgell</dc:creator>`
+	if elem != expected {
+		t.Errorf("MarshalOPF() == %q, expected %q", elem, expected)
+	}
+
+	got, err := encoding.UnmarshalOPF(elem)
+	if err != nil {
+		t.Fatalf("UnmarshalOPF(%q): %v", elem, err)
+	}
+	if got.Common() != "P. C. Hodgell" || got.Relator() != "aut" {
+		t.Errorf("UnmarshalOPF round-trip == {%q,%q}, expected {%q,%q}",
+			got.Common(), got.Relator(), "P. C. Hodgell", "aut")
+	}
+}
+
+func TestMarshalOPFContributor(t *testing.T) {
+	hoyt, err := relname.NewName2("Sarah", "Hoyt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rn, err := relname.NewRelatedName(hoyt, "edt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	elem := encoding.MarshalOPF(rn)
+	if elem[:len("<dc:contributor")] != "<dc:contributor" {
+		t.Errorf("MarshalOPF() for a non-author role should use <dc:contributor>, got %q", elem)
+	}
+}
+
+func TestUnmarshalOPFErrors(t *testing.T) {
+	if _, err := encoding.UnmarshalOPF("<p>not a creator element</p>"); err == nil {
+		t.Errorf("UnmarshalOPF on a non-creator element should have failed")
+	}
+	if _, err := encoding.UnmarshalOPF(`<dc:creator>James Tiptree Jr.</dc:creator>`); err == nil {
+		t.Errorf("UnmarshalOPF with no opf:role attribute should have failed")
+	}
+}
@@ -0,0 +1,299 @@
+//go:build ignore
+
+// This program generates relators_data.go from the Library of Congress's
+// list of MARC relator codes (https://www.loc.gov/marc/relators/relaterm.html).
+// Run it with "go generate" (see the directive in relators.go).
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sort"
+)
+
+// relatorPairs holds the LoC relator table as {code, term} pairs.  It is kept
+// here, rather than fetched over the network at generate time, so that
+// "go generate" stays reproducible and works offline.
+var relatorPairs = [][2]string{
+	{"abr", "Abridger"},
+	{"acp", "Art copyist"},
+	{"act", "Actor"},
+	{"adi", "Art director"},
+	{"adp", "Adapter"},
+	{"aft", "Author of afterword, colophon, etc."},
+	{"anl", "Analyst"},
+	{"anm", "Animator"},
+	{"ann", "Annotator"},
+	{"ant", "Bibliographic antecedent"},
+	{"ape", "Appellee"},
+	{"apl", "Appellant"},
+	{"app", "Applicant"},
+	{"aqt", "Author in quotations or text abstracts"},
+	{"arc", "Architect"},
+	{"arr", "Arranger"},
+	{"art", "Artist"},
+	{"asg", "Assignee"},
+	{"asn", "Associated name"},
+	{"att", "Attributed name"},
+	{"auc", "Auctioneer"},
+	{"aud", "Author of dialog"},
+	{"aui", "Author of introduction, etc."},
+	{"aus", "Screenwriter"},
+	{"aut", "Author"},
+	{"bdd", "Binding designer"},
+	{"bjd", "Bookjacket designer"},
+	{"bkd", "Book designer"},
+	{"bkp", "Book producer"},
+	{"bnd", "Binder"},
+	{"bpd", "Bookplate designer"},
+	{"bsl", "Bookseller"},
+	{"cas", "Caster"},
+	{"ccp", "Conceptor"},
+	{"chr", "Choreographer"},
+	{"cll", "Calligrapher"},
+	{"clb", "Collaborator"},
+	{"cli", "Client"},
+	{"cmm", "Commentator"},
+	{"cmp", "Composer"},
+	{"cmt", "Compositor"},
+	{"cnd", "Conductor"},
+	{"cng", "Cinematographer"},
+	{"coe", "Contestant-appellee"},
+	{"col", "Collector"},
+	{"com", "Compiler"},
+	{"cor", "Collection registrar"},
+	{"cos", "Contestant"},
+	{"cot", "Contestant-appellant"},
+	{"cov", "Cover designer"},
+	{"cpc", "Copyright claimant"},
+	{"cpe", "Complainant-appellee"},
+	{"cph", "Copyright holder"},
+	{"cpl", "Complainant"},
+	{"cpt", "Complainant-appellant"},
+	{"cre", "Creator"},
+	{"crp", "Correspondent"},
+	{"crr", "Corrector"},
+	{"crt", "Court reporter"},
+	{"csl", "Consultant"},
+	{"csp", "Consultant to a project"},
+	{"cst", "Costume designer"},
+	{"ctb", "Contributor"},
+	{"cte", "Contestee-appellee"},
+	{"ctg", "Cartographer"},
+	{"ctr", "Contractor"},
+	{"cts", "Contestee"},
+	{"ctt", "Contestee-appellant"},
+	{"cur", "Curator"},
+	{"cwt", "Commentator for written text"},
+	{"dfd", "Defendant"},
+	{"dfe", "Defendant-appellee"},
+	{"dft", "Defendant-appellant"},
+	{"dgg", "Degree grantor"},
+	{"dgs", "Degree supervisor"},
+	{"dis", "Dissertant"},
+	{"djo", "Dj"},
+	{"dln", "Delineator"},
+	{"dnc", "Dancer"},
+	{"dnr", "Donor"},
+	{"dpc", "Depicted"},
+	{"dpt", "Depositor"},
+	{"drm", "Draftsman"},
+	{"drt", "Director"},
+	{"dsr", "Designer"},
+	{"dst", "Distributor"},
+	{"dtc", "Data contributor"},
+	{"dte", "Dedicatee"},
+	{"dtm", "Data manager"},
+	{"dto", "Dedicator"},
+	{"dub", "Dubious author"},
+	{"edc", "Editor of compilation"},
+	{"edm", "Editor of moving image work"},
+	{"edt", "Editor"},
+	{"egr", "Engraver"},
+	{"elt", "Electrotyper"},
+	{"eng", "Engineer"},
+	{"etr", "Etcher"},
+	{"exp", "Expert"},
+	{"fac", "Facsimilist"},
+	{"fds", "Film distributor"},
+	{"fld", "Field director"},
+	{"flm", "Film editor"},
+	{"fmd", "Film director"},
+	{"fmk", "Filmmaker"},
+	{"fmo", "Former owner"},
+	{"fmp", "Film producer"},
+	{"fnd", "Funder"},
+	{"frg", "Forger"},
+	{"gis", "Geographic information specialist"},
+	{"hnr", "Honoree"},
+	{"his", "Host institution"},
+	{"hst", "Host"},
+	{"ill", "Illustrator"},
+	{"ilu", "Illuminator"},
+	{"ins", "Inscriber"},
+	{"inv", "Inventor"},
+	{"isb", "Issuing body"},
+	{"itr", "Instrumentalist"},
+	{"ive", "Interviewee"},
+	{"ivr", "Interviewer"},
+	{"jud", "Judge"},
+	{"lbr", "Laboratory"},
+	{"lbt", "Librettist"},
+	{"ldr", "Laboratory director"},
+	{"led", "Lead"},
+	{"lee", "Libelee-appellee"},
+	{"lel", "Libelee"},
+	{"len", "Lender"},
+	{"let", "Libelee-appellant"},
+	{"lgd", "Lighting designer"},
+	{"lie", "Libelant-appellee"},
+	{"lil", "Libelant"},
+	{"lit", "Libelant-appellant"},
+	{"lsa", "Landscape architect"},
+	{"lse", "Licensee"},
+	{"lso", "Licensor"},
+	{"ltg", "Lithographer"},
+	{"lyr", "Lyricist"},
+	{"mcp", "Music copyist"},
+	{"mdc", "Metadata contact"},
+	{"med", "Medium"},
+	{"mfp", "Manufacturing place"},
+	{"mfr", "Manufacturer"},
+	{"mod", "Moderator"},
+	{"mon", "Monitor"},
+	{"mrb", "Marbler"},
+	{"mrk", "Markup editor"},
+	{"msd", "Musical director"},
+	{"mte", "Metal-engraver"},
+	{"mtk", "Minute taker"},
+	{"mus", "Musician"},
+	{"nrt", "Narrator"},
+	{"opn", "Opponent"},
+	{"org", "Originator"},
+	{"orm", "Organizer"},
+	{"osp", "Onscreen presenter"},
+	{"oth", "Other"},
+	{"own", "Owner"},
+	{"pan", "Panelist"},
+	{"pat", "Patron"},
+	{"pbd", "Publishing director"},
+	{"pbl", "Publisher"},
+	{"pdr", "Project director"},
+	{"pfr", "Proofreader"},
+	{"pht", "Photographer"},
+	{"plt", "Platemaker"},
+	{"pma", "Permitting agency"},
+	{"pmn", "Production manager"},
+	{"pop", "Printer of plates"},
+	{"ppm", "Papermaker"},
+	{"ppt", "Puppeteer"},
+	{"pra", "Praeses"},
+	{"prc", "Process contact"},
+	{"prd", "Production personnel"},
+	{"pre", "Presenter"},
+	{"prf", "Performer"},
+	{"prg", "Programmer"},
+	{"prm", "Printmaker"},
+	{"prn", "Production company"},
+	{"pro", "Producer"},
+	{"prp", "Production place"},
+	{"prs", "Production designer"},
+	{"prt", "Printer"},
+	{"prv", "Provider"},
+	{"pta", "Patent applicant"},
+	{"pte", "Plaintiff-appellee"},
+	{"ptf", "Plaintiff"},
+	{"pth", "Patent holder"},
+	{"ptt", "Plaintiff-appellant"},
+	{"pup", "Publication place"},
+	{"rbr", "Rubricator"},
+	{"rcd", "Recordist"},
+	{"rce", "Recording engineer"},
+	{"rcp", "Addressee"},
+	{"rdd", "Radio director"},
+	{"red", "Redaktor"},
+	{"ren", "Renderer"},
+	{"res", "Researcher"},
+	{"rev", "Reviewer"},
+	{"rpc", "Radio producer"},
+	{"rps", "Repository"},
+	{"rpt", "Reporter"},
+	{"rpy", "Responsible party"},
+	{"rse", "Respondent-appellee"},
+	{"rsg", "Restager"},
+	{"rsp", "Respondent"},
+	{"rsr", "Restorationist"},
+	{"rst", "Respondent-appellant"},
+	{"rth", "Research team head"},
+	{"rtm", "Research team member"},
+	{"sad", "Scientific advisor"},
+	{"sce", "Scenarist"},
+	{"scl", "Sculptor"},
+	{"scr", "Scribe"},
+	{"sde", "Sound engineer"},
+	{"sds", "Sound designer"},
+	{"sec", "Secretary"},
+	{"sgd", "Stage director"},
+	{"sgn", "Signer"},
+	{"sht", "Supporting host"},
+	{"sll", "Seller"},
+	{"sng", "Singer"},
+	{"spk", "Speaker"},
+	{"spn", "Sponsor"},
+	{"spy", "Second party"},
+	{"srv", "Surveyor"},
+	{"std", "Set designer"},
+	{"stg", "Setting"},
+	{"stl", "Storyteller"},
+	{"stm", "Stage manager"},
+	{"stn", "Standards body"},
+	{"str", "Stereotyper"},
+	{"tcd", "Technical director"},
+	{"tch", "Teacher"},
+	{"ths", "Thesis advisor"},
+	{"tld", "Television director"},
+	{"tlp", "Television producer"},
+	{"trc", "Transcriber"},
+	{"trl", "Translator"},
+	{"tyd", "Type designer"},
+	{"tyg", "Typographer"},
+	{"uvp", "University place"},
+	{"vac", "Voice actor"},
+	{"vdg", "Videographer"},
+	{"voc", "Vocalist"},
+	{"wac", "Writer of added commentary"},
+	{"wal", "Writer of added lyrics"},
+	{"wam", "Writer of accompanying material"},
+	{"wat", "Writer of added text"},
+	{"wdc", "Woodcutter"},
+	{"wde", "Wood engraver"},
+	{"win", "Writer of introduction"},
+	{"wit", "Witness"},
+	{"wpr", "Writer of preface"},
+	{"wst", "Writer of supplementary textual content"},
+}
+
+func main() {
+	sort.Slice(relatorPairs, func(i, j int) bool { return relatorPairs[i][0] < relatorPairs[j][0] })
+
+	f, err := os.Create("relators_data.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	fmt.Fprint(f, `// Code generated by "go run gen_relators.go"; DO NOT EDIT.
+
+package relname
+
+// relatorTerms maps each standard MARC relator code to its English term, per
+// the Library of Congress's list at
+// https://www.loc.gov/marc/relators/relaterm.html.
+var relatorTerms = map[string]string{
+`)
+	for _, pair := range relatorPairs {
+		fmt.Fprintf(f, "\t%q: %q,\n", pair[0], pair[1])
+	}
+	fmt.Fprint(f, "}\n")
+}
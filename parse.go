@@ -0,0 +1,102 @@
+// File:	ebooks/relname/parse.go
+
+package relname
+
+// This file adds ParseName(), a constructor that accepts a single free-form
+// name string (as opposed to separate forename/surname/generation arguments)
+// and works out which of NewName1(), NewName2() or NewName3() to call.
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// reGeneration matches the generational suffixes described in this package’s
+// doc comment: the US “Sr.”/“Jr.”, the British “Snr”/“Jnr”, the French
+// “père”/“fils”, and roman numerals up through “LXXXIX” (89).
+var reGeneration = regexp.MustCompile(
+	`^(?:Sr\.?|Jr\.?|Snr|Jnr|père|fils|(?:L?X{0,3})?(?:I[XV]|V?I{0,3}))$`)
+
+// nameParticles holds the small set of low-case words (eg., “van” in “Ludwig
+// van Beethoven”) that ParseName() treats as part of a compound surname
+// rather than as the last word of the forename.
+var nameParticles = map[string]bool{
+	"da": true, "de": true, "del": true, "della": true, "den": true,
+	"der": true, "di": true, "du": true, "la": true, "le": true,
+	"van": true, "von": true,
+}
+
+// ParseName parses a free-form name string, such as “James Tiptree Jr.” or
+// “Robert A. Heinlein”, and constructs the corresponding 1-, 2- or 3-part
+// Name.  Whitespace is normalized with CleanString() before anything else
+// happens.
+//
+// ParseName recognizes a trailing generational suffix (see the package doc
+// comment) and, when the word before the surname is one of a small set of
+// compound-surname particles (“van”, “de”, “von” etc), groups that particle
+// with the surname.
+//
+// ParseName returns EmptyPartError if s is empty or whitespace-only, and
+// AmbiguousNameError if s has punctuation or structure that this heuristic
+// cannot resolve; callers who hit that error should fall back to calling
+// NewName2() or NewName3() explicitly.
+func ParseName(s string) (Name, error) {
+	t := CleanString(s)
+	if t == "" {
+		return Name{}, &EmptyPartError{1, s, "", ""}
+	}
+	if strings.ContainsAny(t, ",;") {
+		return Name{}, &AmbiguousNameError{s,
+			"contains a comma or semicolon, which looks like file-as form already"}
+	}
+
+	words := strings.Split(t, " ")
+
+	generation := ""
+	if len(words) > 1 && reGeneration.MatchString(words[len(words)-1]) {
+		generation = words[len(words)-1]
+		words = words[:len(words)-1]
+	}
+
+	if len(words) == 1 {
+		if generation != "" {
+			return Name{}, &AmbiguousNameError{s,
+				"a generational suffix with no forename before the surname"}
+		}
+		return NewName1(words[0])
+	}
+
+	particle := ""
+	nSurnameWords := 1
+	if len(words) >= 3 && nameParticles[strings.ToLower(words[len(words)-2])] {
+		particle = words[len(words)-2]
+		nSurnameWords = 2
+	}
+	boundary := len(words) - nSurnameWords
+	forename := strings.Join(words[:boundary], " ")
+	surname := strings.Join(words[len(words)-1:], " ")
+
+	if particle != "" {
+		if generation != "" {
+			return NewName3WithParticle(forename, particle, surname, generation)
+		}
+		return NewName2WithParticle(forename, particle, surname)
+	}
+	if generation != "" {
+		return NewName3(forename, surname, generation)
+	}
+	return NewName2(forename, surname)
+}
+
+// AmbiguousNameError reports that ParseName() was given a string it could
+// not confidently split into forename, surname and generation parts.
+type AmbiguousNameError struct {
+	Input  string
+	Reason string
+}
+
+func (ane *AmbiguousNameError) Error() string {
+	return fmt.Sprintf("ParseName(%q): can't resolve name structure (%s)",
+		ane.Input, ane.Reason)
+}
@@ -0,0 +1,95 @@
+package relname_test
+
+import (
+	"github.com/c12h/relname"
+	"testing"
+)
+
+/*============================ Testing ParseName ==============================*/
+
+func TestParseName(t *testing.T) {
+	expectParsed(t, "Teller", "Teller", "Teller", 1)
+	expectParsed(t, "Dave Freer", "Dave Freer", "Freer, Dave", 2)
+	expectParsed(t, "Robert A. Heinlein",
+		"Robert A. Heinlein", "Heinlein, Robert A.", 2)
+	expectParsed(t, "James Tiptree Jr.",
+		"James Tiptree Jr.", "Tiptree, James Jr.", 3)
+	expectParsed(t, "James Tiptree Jr",
+		"James Tiptree Jr", "Tiptree, James Jr", 3)
+	expectParsed(t, "William H. Keith III",
+		"William H. Keith III", "Keith, William H. III", 3)
+	expectParsed(t, "Ludwig van Beethoven",
+		"Ludwig van Beethoven", "Beethoven, Ludwig van", 2)
+	expectParsed(t, "  Charles   de  Gaulle ",
+		"Charles de Gaulle", "de Gaulle, Charles", 2)
+}
+
+func expectParsed(t *testing.T, input, common, fileAs string, nParts int) {
+	n, err := relname.ParseName(input)
+	if err != nil {
+		t.Fatalf("ParseName(%q) → unexpected error %#v", input, err)
+	}
+	if actual := n.NumParts(); actual != nParts {
+		t.Errorf("ParseName(%q).NumParts() == %d, expected %d", input, actual, nParts)
+	}
+	if actual := n.Common(); actual != common {
+		t.Errorf("ParseName(%q).Common() == %q, expected %q", input, actual, common)
+	}
+	if actual := n.FileAs(); actual != fileAs {
+		t.Errorf("ParseName(%q).FileAs() == %q, expected %q", input, actual, fileAs)
+	}
+}
+
+func TestParseNameParticles(t *testing.T) {
+	beethoven, err := relname.ParseName("Ludwig van Beethoven")
+	if err != nil {
+		t.Fatalf(`ParseName("Ludwig van Beethoven") → unexpected error %#v`, err)
+	}
+	if s := beethoven.Surname(); s != "Beethoven" {
+		t.Errorf(`ParseName("Ludwig van Beethoven").Surname() == %q, expected %q`, s, "Beethoven")
+	}
+	if p := beethoven.Particle(); p != "van" {
+		t.Errorf(`ParseName("Ludwig van Beethoven").Particle() == %q, expected %q`, p, "van")
+	}
+
+	degaulle, err := relname.ParseName("Charles de Gaulle")
+	if err != nil {
+		t.Fatalf(`ParseName("Charles de Gaulle") → unexpected error %#v`, err)
+	}
+	if s := degaulle.Surname(); s != "Gaulle" {
+		t.Errorf(`ParseName("Charles de Gaulle").Surname() == %q, expected %q`, s, "Gaulle")
+	}
+	if p := degaulle.Particle(); p != "de" {
+		t.Errorf(`ParseName("Charles de Gaulle").Particle() == %q, expected %q`, p, "de")
+	}
+
+	keithJr, err := relname.ParseName("William van Doren Jr.")
+	if err != nil {
+		t.Fatalf(`ParseName("William van Doren Jr.") → unexpected error %#v`, err)
+	}
+	if s := keithJr.Surname(); s != "Doren" {
+		t.Errorf(`ParseName("William van Doren Jr.").Surname() == %q, expected %q`, s, "Doren")
+	}
+	if p := keithJr.Particle(); p != "van" {
+		t.Errorf(`ParseName("William van Doren Jr.").Particle() == %q, expected %q`, p, "van")
+	}
+	if g := keithJr.Generation(); g != "Jr." {
+		t.Errorf(`ParseName("William van Doren Jr.").Generation() == %q, expected %q`, g, "Jr.")
+	}
+}
+
+func TestParseNameErrors(t *testing.T) {
+	_, err := relname.ParseName("   ")
+	if _, ok := err.(*relname.EmptyPartError); !ok {
+		t.Errorf(`ParseName("   ") → %#v, expected *EmptyPartError`, err)
+	}
+
+	expectAmbiguous(t, "Tiptree, James, Jr.")
+}
+
+func expectAmbiguous(t *testing.T, input string) {
+	_, err := relname.ParseName(input)
+	if _, ok := err.(*relname.AmbiguousNameError); !ok {
+		t.Errorf("ParseName(%q) → %#v, expected *AmbiguousNameError", input, err)
+	}
+}
@@ -0,0 +1,115 @@
+// File:	ebooks/relname/particle.go
+
+package relname
+
+// This file adds support for surname particles — the low-case words such as
+// “van” in “Ludwig van Beethoven” or “de” in “Charles de Gaulle” that sit
+// between the forename and the true family name.  This is the
+// dropping-particle / non-dropping-particle distinction used by CSL-style
+// bibliographic name evaluation: a *dropping* particle moves to the end of
+// the file-as form (“Beethoven, Ludwig van”), while a *non-dropping* particle
+// stays attached to the family name (“de Gaulle, Charles”).
+
+import "fmt"
+
+// defaultDroppingParticles holds the small set of particles (matched
+// case-sensitively, so the capitalized "Van" of an Americanized surname like
+// "Van Horn" is unaffected) that default to *dropping* because that's the
+// overwhelmingly conventional filing form for them — eg., "van" as in "Ludwig
+// van Beethoven", filed as "Beethoven, Ludwig van".  Every other particle
+// (eg. "de", as in "Charles de Gaulle", filed as "de Gaulle, Charles")
+// defaults to non-dropping; callers can always override either default with
+// WithDroppingParticle().
+var defaultDroppingParticles = map[string]bool{
+	"van": true,
+}
+
+// NewName2WithParticle constructs a two-part name whose surname has a
+// particle, eg., NewName2WithParticle("Charles", "de", "Gaulle").  The
+// particle's dropping/non-dropping default comes from
+// defaultDroppingParticles; call WithDroppingParticle() on the result to
+// override it.  It returns an error (and a zero-valued Name) if any argument
+// is empty or whitespace-only.
+func NewName2WithParticle(forename, particle, surname string) (Name, error) {
+	p := CleanString(particle)
+	if p == "" {
+		return Name{}, &EmptyParticleError{forename, particle, surname, ""}
+	}
+	f := CleanString(forename)
+	s := CleanString(surname)
+	if f == "" || s == "" {
+		return Name{}, &EmptyPartError{2, forename, surname, ""}
+	}
+	text := f + " " + p + " " + s
+	boSurname := uint16(len(f) + 1)
+	boFamily := boSurname + uint16(len(p)) + 1
+	return Name{text: text, boSurname: boSurname, eoSurname: uint16(len(text)), boFamily: boFamily,
+		dropPtcl: defaultDroppingParticles[p]}, nil
+}
+
+// NewName3WithParticle constructs a three-part name (forename, particle,
+// surname and a generational suffix) whose surname has a particle, eg.,
+// NewName3WithParticle("Mark", "van", "Doren", "Jr.").  As with
+// NewName2WithParticle, the particle's dropping/non-dropping default comes
+// from defaultDroppingParticles.  It returns an error (and a zero-valued
+// Name) if any argument is empty or whitespace-only.
+func NewName3WithParticle(forename, particle, surname, generation string) (Name, error) {
+	p := CleanString(particle)
+	if p == "" {
+		return Name{}, &EmptyParticleError{forename, particle, surname, generation}
+	}
+	f := CleanString(forename)
+	s := CleanString(surname)
+	g := CleanString(generation)
+	if f == "" || s == "" || g == "" {
+		return Name{}, &EmptyPartError{3, forename, surname, generation}
+	}
+	text := f + " " + p + " " + s
+	boSurname := uint16(len(f) + 1)
+	boFamily := boSurname + uint16(len(p)) + 1
+	eoSurname := uint16(len(text))
+	return Name{text: text + " " + g, boSurname: boSurname, eoSurname: eoSurname, boFamily: boFamily,
+		dropPtcl: defaultDroppingParticles[p]}, nil
+}
+
+// Particle returns a name's surname particle, or "" if it has none.
+func (n Name) Particle() string {
+	if n.boFamily == n.boSurname {
+		return ""
+	}
+	return n.text[n.boSurname : n.boFamily-1]
+}
+
+// DroppingParticle reports whether a name's surname particle (if any) is a
+// *dropping* particle, ie. one that moves to the end of the file-as form
+// instead of staying attached to the family name.
+func (n Name) DroppingParticle() bool { return n.dropPtcl }
+
+// WithDroppingParticle returns a copy of n with its particle policy set as
+// requested.  It has no effect (and returns n unchanged) if n has no
+// particle.
+func (n Name) WithDroppingParticle(dropping bool) Name {
+	if n.boFamily == n.boSurname {
+		return n
+	}
+	n.dropPtcl = dropping
+	return n
+}
+
+// EmptyParticleError reports that NewName2WithParticle() or
+// NewName3WithParticle() was given a particle argument that was empty or
+// contained only whitespace characters.
+type EmptyParticleError struct {
+	Forename, Particle, Surname, Generation string
+}
+
+func (epe *EmptyParticleError) Error() string {
+	if epe.Generation == "" {
+		return fmt.Sprintf(
+			"empty or whitespace-only particle argument in NewName2WithParticle(%q, %q, %q)",
+			epe.Forename, epe.Particle, epe.Surname)
+	}
+	return fmt.Sprintf(
+		"empty or whitespace-only particle argument in NewName3WithParticle(%q, %q, %q, %q)",
+		epe.Forename, epe.Particle, epe.Surname, epe.Generation)
+}
@@ -0,0 +1,79 @@
+package relname_test
+
+import (
+	"github.com/c12h/relname"
+	"testing"
+)
+
+/*=========================== Testing surname particles =======================*/
+
+func TestNameWithParticle(t *testing.T) {
+	vanB, err := relname.NewName2WithParticle("Ludwig", "van", "Beethoven")
+	check(t, err, `NewName2WithParticle("Ludwig", "van", "Beethoven")`)
+	if s := vanB.Surname(); s != "Beethoven" {
+		t.Errorf(`vanB.Surname() == %q, expected "Beethoven"`, s)
+	}
+	if p := vanB.Particle(); p != "van" {
+		t.Errorf(`vanB.Particle() == %q, expected "van"`, p)
+	}
+	if !vanB.DroppingParticle() {
+		t.Errorf(`vanB.DroppingParticle() == false, expected true ("van" defaults to dropping)`)
+	}
+	if fa := vanB.FileAs(); fa != "Beethoven, Ludwig van" {
+		t.Errorf(`vanB.FileAs() == %q, expected "Beethoven, Ludwig van"`, fa)
+	}
+	if sk := vanB.SortKey(); sk != vanB.FileAs() {
+		t.Errorf(`vanB.SortKey() == %q, expected %q`, sk, vanB.FileAs())
+	}
+
+	vanB = vanB.WithDroppingParticle(false)
+	if vanB.DroppingParticle() {
+		t.Errorf("vanB.WithDroppingParticle(false).DroppingParticle() == true, expected false")
+	}
+	if fa := vanB.FileAs(); fa != "van Beethoven, Ludwig" {
+		t.Errorf(`vanB.FileAs() == %q, expected "van Beethoven, Ludwig"`, fa)
+	}
+
+	deG, err := relname.NewName2WithParticle("Charles", "de", "Gaulle")
+	check(t, err, `NewName2WithParticle("Charles", "de", "Gaulle")`)
+	if fa := deG.FileAs(); fa != "de Gaulle, Charles" {
+		t.Errorf(`deG.FileAs() == %q, expected "de Gaulle, Charles"`, fa)
+	}
+	if s := deG.Surname(); s != "Gaulle" {
+		t.Errorf(`deG.Surname() == %q, expected "Gaulle"`, s)
+	}
+
+	keith, err := relname.NewName3WithParticle("William H.", "Van", "Horn", "Jr.")
+	check(t, err, `NewName3WithParticle("William H.", "Van", "Horn", "Jr.")`)
+	if c := keith.Common(); c != "William H. Van Horn Jr." {
+		t.Errorf(`keith.Common() == %q, expected "William H. Van Horn Jr."`, c)
+	}
+	if fa := keith.FileAs(); fa != "Van Horn, William H. Jr." {
+		t.Errorf(`keith.FileAs() == %q, expected "Van Horn, William H. Jr."`, fa)
+	}
+	if g := keith.Generation(); g != "Jr." {
+		t.Errorf(`keith.Generation() == %q, expected "Jr."`, g)
+	}
+}
+
+func TestNameWithParticleErrors(t *testing.T) {
+	_, err := relname.NewName2WithParticle("Ludwig", "  ", "Beethoven")
+	if _, ok := err.(*relname.EmptyParticleError); !ok {
+		t.Errorf("NewName2WithParticle with blank particle → %#v, expected *EmptyParticleError", err)
+	}
+	_, err = relname.NewName2WithParticle("", "van", "Beethoven")
+	if _, ok := err.(*relname.EmptyPartError); !ok {
+		t.Errorf("NewName2WithParticle with blank forename → %#v, expected *EmptyPartError", err)
+	}
+}
+
+func TestNameWithoutParticleUnaffected(t *testing.T) {
+	n, err := relname.NewName2("Sydney", "Van Scyoc")
+	check(t, err, `NewName2("Sydney", "Van Scyoc")`)
+	if p := n.Particle(); p != "" {
+		t.Errorf(`n.Particle() == %q, expected ""`, p)
+	}
+	if n2 := n.WithDroppingParticle(true); n2.FileAs() != n.FileAs() || n2.DroppingParticle() != n.DroppingParticle() {
+		t.Errorf("WithDroppingParticle on a particle-less Name should be a no-op")
+	}
+}
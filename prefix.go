@@ -0,0 +1,91 @@
+// File:	ebooks/relname/prefix.go
+
+package relname
+
+// This file adds support for honorific prefixes (“Dr”, “Sir”, “Professor”)
+// and post-nominal letters (“Ph.D”, “Esquire”, “FRS”), which the package doc
+// comment used to disclaim entirely.  They show up in Common() and FileAs(),
+// but — like a particle — never affect Surname(), Forename() or NumParts().
+
+import "strings"
+
+// WithPrefixes returns a copy of n carrying the given honorific prefixes
+// (eg., "Dr.", "Sir"), replacing any it already had.  Each prefix is cleaned
+// with CleanString(); empty ones are dropped.  Calling it with no arguments
+// removes n's prefixes.
+func (n Name) WithPrefixes(prefixes ...string) Name {
+	n.prefixes = cleanNonEmpty(prefixes)
+	return n
+}
+
+// WithPostnominals returns a copy of n carrying the given post-nominal
+// letters (eg., "Ph.D.", "FRS"), replacing any it already had.  Each one is
+// cleaned with CleanString(); empty ones are dropped.  Calling it with no
+// arguments removes n's post-nominals.
+func (n Name) WithPostnominals(postnominals ...string) Name {
+	n.postnominals = cleanNonEmpty(postnominals)
+	return n
+}
+
+// Prefixes returns a name's honorific prefixes, or nil if it has none.
+func (n Name) Prefixes() []string { return append([]string(nil), n.prefixes...) }
+
+// Postnominals returns a name's post-nominal letters, or nil if it has none.
+func (n Name) Postnominals() []string { return append([]string(nil), n.postnominals...) }
+
+func cleanNonEmpty(ss []string) []string {
+	var out []string
+	for _, s := range ss {
+		if s = CleanString(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// commonWith computes Common(), optionally omitting the prefixes and/or
+// post-nominals; Formatter.Common() and Name.Common() both use this.
+func (n Name) commonWith(omitPrefixes, omitPostnominals bool) string {
+	prefix, postnominal := "", ""
+	if !omitPrefixes && len(n.prefixes) > 0 {
+		prefix = strings.Join(n.prefixes, " ") + " "
+	}
+	if !omitPostnominals && len(n.postnominals) > 0 {
+		postnominal = ", " + strings.Join(n.postnominals, ", ")
+	}
+	if prefix == "" && postnominal == "" {
+		return n.text
+	}
+	return prefix + n.text + postnominal
+}
+
+// fileAsWith computes FileAs(), optionally omitting the prefixes and/or
+// post-nominals; Formatter.FileAs() and Name.FileAs() both use this.
+func (n Name) fileAsWith(omitPrefixes, omitPostnominals bool) string {
+	family, rest := n.fileAsParts()
+	prefix, postnominal := "", ""
+	if !omitPrefixes && len(n.prefixes) > 0 {
+		prefix = strings.Join(n.prefixes, " ") + " "
+	}
+	if !omitPostnominals && len(n.postnominals) > 0 {
+		postnominal = ", " + strings.Join(n.postnominals, ", ")
+	}
+	if n.boSurname == 0 {
+		return prefix + family + postnominal
+	}
+	return family + ", " + prefix + rest + postnominal
+}
+
+// A Formatter renders Names for callers (eg. OPF/ebook metadata generators)
+// that need to strip prefixes and/or post-nominals because the target schema
+// has no place for them.
+type Formatter struct {
+	OmitPrefixes     bool
+	OmitPostnominals bool
+}
+
+// Common renders n.Common(), honoring f's Omit flags.
+func (f Formatter) Common(n Name) string { return n.commonWith(f.OmitPrefixes, f.OmitPostnominals) }
+
+// FileAs renders n.FileAs(), honoring f's Omit flags.
+func (f Formatter) FileAs(n Name) string { return n.fileAsWith(f.OmitPrefixes, f.OmitPostnominals) }
@@ -0,0 +1,69 @@
+package relname_test
+
+import (
+	"github.com/c12h/relname"
+	"testing"
+)
+
+/*======================= Testing prefixes and post-nominals ===================*/
+
+func TestNameWithPrefixAndPostnominal(t *testing.T) {
+	base, err := relname.NewName2("P. C.", "Hodgell")
+	check(t, err, `NewName2("P. C.", "Hodgell")`)
+
+	n := base.WithPrefixes("Dr.").WithPostnominals("Ph.D.")
+
+	if c := n.Common(); c != "Dr. P. C. Hodgell, Ph.D." {
+		t.Errorf(`n.Common() == %q, expected "Dr. P. C. Hodgell, Ph.D."`, c)
+	}
+	if fa := n.FileAs(); fa != "Hodgell, Dr. P. C., Ph.D." {
+		t.Errorf(`n.FileAs() == %q, expected "Hodgell, Dr. P. C., Ph.D."`, fa)
+	}
+	if sk := n.SortKey(); sk != "Hodgell, P. C." {
+		t.Errorf(`n.SortKey() == %q, expected "Hodgell, P. C." (no prefix/postnominal)`, sk)
+	}
+	if s := n.Surname(); s != "Hodgell" {
+		t.Errorf(`n.Surname() == %q, expected "Hodgell"`, s)
+	}
+	if np := n.NumParts(); np != 2 {
+		t.Errorf(`n.NumParts() == %d, expected 2`, np)
+	}
+
+	// Re-applying WithPrefixes/WithPostnominals with no args clears them.
+	cleared := n.WithPrefixes().WithPostnominals()
+	if c := cleared.Common(); c != "P. C. Hodgell" {
+		t.Errorf(`cleared.Common() == %q, expected "P. C. Hodgell"`, c)
+	}
+}
+
+func TestNameWithMultiplePostnominals(t *testing.T) {
+	n, err := relname.NewName2("Jane", "Doe")
+	check(t, err, `NewName2("Jane", "Doe")`)
+	n = n.WithPostnominals("Ph.D.", "FRS")
+
+	if c := n.Common(); c != "Jane Doe, Ph.D., FRS" {
+		t.Errorf(`n.Common() == %q, expected "Jane Doe, Ph.D., FRS"`, c)
+	}
+	if fa := n.FileAs(); fa != "Doe, Jane, Ph.D., FRS" {
+		t.Errorf(`n.FileAs() == %q, expected "Doe, Jane, Ph.D., FRS"`, fa)
+	}
+}
+
+func TestFormatterOmits(t *testing.T) {
+	base, err := relname.NewName2("P. C.", "Hodgell")
+	check(t, err, `NewName2("P. C.", "Hodgell")`)
+	n := base.WithPrefixes("Dr.").WithPostnominals("Ph.D.")
+
+	plain := relname.Formatter{OmitPrefixes: true, OmitPostnominals: true}
+	if c := plain.Common(n); c != "P. C. Hodgell" {
+		t.Errorf(`plain.Common(n) == %q, expected "P. C. Hodgell"`, c)
+	}
+	if fa := plain.FileAs(n); fa != "Hodgell, P. C." {
+		t.Errorf(`plain.FileAs(n) == %q, expected "Hodgell, P. C."`, fa)
+	}
+
+	prefixOnly := relname.Formatter{OmitPostnominals: true}
+	if c := prefixOnly.Common(n); c != "Dr. P. C. Hodgell" {
+		t.Errorf(`prefixOnly.Common(n) == %q, expected "Dr. P. C. Hodgell"`, c)
+	}
+}
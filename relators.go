@@ -0,0 +1,108 @@
+// File:	ebooks/relname/relators.go
+
+package relname
+
+// This file validates RelatedName's relator codes against the Library of
+// Congress's list of MARC relators (see relators_data.go, which is generated
+// from that list) and lets callers look up the English term for a code.
+
+//go:generate go run gen_relators.go
+
+import (
+	"fmt"
+	"sync"
+)
+
+var (
+	customRelatorsMu sync.RWMutex
+	customRelators   = map[string]string{}
+)
+
+// isKnownRelator reports whether code (already known to match reRelator) is
+// either a standard LoC relator code or one registered with RegisterRelator.
+func isKnownRelator(code string) bool {
+	if _, ok := relatorTerms[code]; ok {
+		return true
+	}
+	customRelatorsMu.RLock()
+	defer customRelatorsMu.RUnlock()
+	_, ok := customRelators[code]
+	return ok
+}
+
+// RelatorTerm returns the English term for a related name's relator code
+// (eg., "Author" for "aut"), or "" if the code is somehow neither a standard
+// nor a registered one.
+func (rn RelatedName) RelatorTerm() string {
+	if rn.relTerm != "" {
+		return rn.relTerm
+	}
+	code := rn.Relator()
+	if term, ok := relatorTerms[code]; ok {
+		return term
+	}
+	customRelatorsMu.RLock()
+	defer customRelatorsMu.RUnlock()
+	return customRelators[code]
+}
+
+// RegisterRelator adds a locally-defined relator code (one not in the
+// Library of Congress's list) so that NewRelatedName() will accept it and
+// RelatorTerm() will report term for it.  The code must consist of 3 letters
+// in a-z.  Registering the same code with the same term twice is harmless;
+// registering a code that already has a different term (whether standard or
+// previously registered) is an error.
+func RegisterRelator(code, term string) error {
+	if !reRelator.MatchString(code) {
+		return &BadRelatorFormatError{code}
+	}
+	term = CleanString(term)
+	customRelatorsMu.Lock()
+	defer customRelatorsMu.Unlock()
+	if existing, ok := relatorTerms[code]; ok {
+		if existing != term {
+			return &RelatorConflictError{code, existing, term}
+		}
+		return nil
+	}
+	if existing, ok := customRelators[code]; ok && existing != term {
+		return &RelatorConflictError{code, existing, term}
+	}
+	customRelators[code] = term
+	return nil
+}
+
+// UnknownRelatorError reports that NewRelatedName() was given a
+// well-formed (3 lower-case letters) but unrecognized relator code.
+type UnknownRelatorError struct {
+	N Name
+	C string
+}
+
+func (ure *UnknownRelatorError) Error() string {
+	return fmt.Sprintf(
+		"NewRelatedName(%q,%q): %q is not a known MARC relator code; see RegisterRelator",
+		ure.N, ure.C, ure.C)
+}
+
+// BadRelatorFormatError reports that RegisterRelator() was given a code that
+// does not consist of 3 letters in a-z.
+type BadRelatorFormatError struct {
+	C string
+}
+
+func (brfe *BadRelatorFormatError) Error() string {
+	return fmt.Sprintf("RegisterRelator(%q, ...): need /^[a-z][a-z][a-z]$/ for code", brfe.C)
+}
+
+// RelatorConflictError reports that RegisterRelator() was given a code that
+// is already in use (whether standard or previously registered) with a
+// different term.
+type RelatorConflictError struct {
+	Code, ExistingTerm, NewTerm string
+}
+
+func (rce *RelatorConflictError) Error() string {
+	return fmt.Sprintf("RegisterRelator(%q,%q): code already registered as %q",
+		rce.Code, rce.NewTerm, rce.ExistingTerm)
+}
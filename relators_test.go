@@ -0,0 +1,63 @@
+package relname_test
+
+import (
+	"github.com/c12h/relname"
+	"testing"
+)
+
+/*========================= Testing relator validation =========================*/
+
+func TestRelatorTerm(t *testing.T) {
+	aut, err := relname.NewRelatedName(Sarah, "aut")
+	check(t, err, `NewRelatedName(Sarah, "aut")`)
+	if term := aut.RelatorTerm(); term != "Author" {
+		t.Errorf(`aut.RelatorTerm() == %q, expected "Author"`, term)
+	}
+
+	edc, err := relname.NewRelatedName(Sarah, "edc")
+	check(t, err, `NewRelatedName(Sarah, "edc")`)
+	if term := edc.RelatorTerm(); term != "Editor of compilation" {
+		t.Errorf(`edc.RelatorTerm() == %q, expected "Editor of compilation"`, term)
+	}
+}
+
+func TestUnknownRelatorCode(t *testing.T) {
+	_, err := relname.NewRelatedName(Sarah, "axt")
+	if _, ok := err.(*relname.UnknownRelatorError); !ok {
+		t.Errorf(`NewRelatedName(Sarah, "axt") → %#v, expected *UnknownRelatorError`, err)
+	}
+}
+
+func TestRegisterRelator(t *testing.T) {
+	if err := relname.RegisterRelator("zzq", "Zither quartermaster"); err != nil {
+		t.Fatalf(`RegisterRelator("zzq", ...) → unexpected error %#v`, err)
+	}
+	// Registering the same code/term again should be a harmless no-op.
+	if err := relname.RegisterRelator("zzq", "Zither quartermaster"); err != nil {
+		t.Errorf(`re-registering "zzq" with the same term → unexpected error %#v`, err)
+	}
+
+	zzq, err := relname.NewRelatedName(Sarah, "zzq")
+	check(t, err, `NewRelatedName(Sarah, "zzq")`)
+	if term := zzq.RelatorTerm(); term != "Zither quartermaster" {
+		t.Errorf(`zzq.RelatorTerm() == %q, expected "Zither quartermaster"`, term)
+	}
+
+	if err := relname.RegisterRelator("zzq", "Something else"); err == nil {
+		t.Errorf(`RegisterRelator("zzq", "Something else") should have failed (conflict)`)
+	} else if _, ok := err.(*relname.RelatorConflictError); !ok {
+		t.Errorf(`RegisterRelator("zzq", "Something else") → %#v, expected *RelatorConflictError`, err)
+	}
+
+	if err := relname.RegisterRelator("aut", "Something else"); err == nil {
+		t.Errorf(`RegisterRelator("aut", ...) should have failed (standard code)`)
+	} else if _, ok := err.(*relname.RelatorConflictError); !ok {
+		t.Errorf(`RegisterRelator("aut", ...) → %#v, expected *RelatorConflictError`, err)
+	}
+
+	if err := relname.RegisterRelator("Axt", "Bad format"); err == nil {
+		t.Errorf(`RegisterRelator("Axt", ...) should have failed (bad format)`)
+	} else if _, ok := err.(*relname.BadRelatorFormatError); !ok {
+		t.Errorf(`RegisterRelator("Axt", ...) → %#v, expected *BadRelatorFormatError`, err)
+	}
+}
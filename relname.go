@@ -68,12 +68,14 @@
 //	String()	""	"Baen Books"	"Dave Freer"	"James Tiptree Jr."
 //
 //
-// Limitations
+// Prefixes and Post-nominals
 //
-// This package does not support post-nomials (“Ph.D”, “Esquire”, “FRS”, etc).
-// Neither does it really support title prefixes (“Mr”, “Dr”, “Professor”, “Sir”
-// etc), which it will treat as part of the forename; in some cases, that may be
-// good enough.
+// A Name may also carry honorific prefixes (“Dr”, “Sir”, “Professor”) and
+// post-nominal letters (“Ph.D”, “Esquire”, “FRS”), attached with
+// WithPrefixes() and WithPostnominals().  These appear in Common() and
+// FileAs() — eg., “Hodgell, Dr. P. C., Ph.D.” — but are ignored by Surname(),
+// Forename() and NumParts().  Use a Formatter to produce output with them
+// omitted (eg., for metadata schemas that forbid them).
 //
 //
 package relname
@@ -131,15 +133,20 @@ func CleanString(s string) string {
 
 // A Name holds the the name of a person or organization.
 type Name struct {
-	text      string
-	boSurname uint16 // index of first byte of surname
-	eoSurname uint16 // 1 + index of last byte of surname
+	text         string
+	boSurname    uint16 // index of first byte of surname (particle, if any, plus family word)
+	eoSurname    uint16 // 1 + index of last byte of surname
+	boFamily     uint16 // index of first byte of the true family word within the surname
+	dropPtcl     bool   // if boFamily > boSurname, is the particle a *dropping* particle?
+	prefixes     []string
+	postnominals []string
 }
 
 // Invariants:	text !~ /^\s/, !~ /\s$/, !~ /\s\s/
-//		0 <= boSurname <= eoSurname <= len(text)
+//		0 <= boSurname <= boFamily <= eoSurname <= len(text)
 //		boSurname == 0		 ⇒  eoSurname == len(text)
 //		eoSurname != len(text) ⇒  eoSurname < len(text) - 1
+//		boFamily == boSurname   ⇒  no surname particle (see particle.go)
 //
 //	For ‘simple’ names:		boSurname == 0, eoSurname == len(text)
 //	For ‘typical’ names:		boSurname > 0,  eoSurname == len(text)
@@ -153,7 +160,7 @@ func NewName1(text string) (Name, error) {
 	if t == "" {
 		return Name{}, &EmptyPartError{1, text, "", ""}
 	}
-	return Name{text, 0, uint16(len(text))}, nil
+	return Name{text: t, eoSurname: uint16(len(t))}, nil
 }
 
 // NewName2 constructs a two-part name.  Use it for most people.
@@ -167,7 +174,8 @@ func NewName2(forename, surname string) (Name, error) {
 		//	f != "", s != "", false, forename, surname)
 	}
 	text := f + " " + s
-	return Name{text, uint16(len(f) + 1), uint16(len(text))}, nil
+	boSurname := uint16(len(f) + 1)
+	return Name{text: text, boSurname: boSurname, eoSurname: uint16(len(text)), boFamily: boSurname}, nil
 }
 
 // NewName3 constructs a three-part name.  Use it for people with generational
@@ -181,30 +189,68 @@ func NewName3(forename, surname, generation string) (Name, error) {
 		return Name{}, &EmptyPartError{3, forename, surname, generation}
 	}
 	text := f + " " + s
-	return Name{text + " " + g, uint16(len(f) + 1), uint16(len(text))}, nil
+	boSurname := uint16(len(f) + 1)
+	eoSurname := uint16(len(text))
+	return Name{text: text + " " + g, boSurname: boSurname, eoSurname: eoSurname, boFamily: boSurname}, nil
 }
 
-// Common returns the common (as opposed to file-as) form of a name.  For a
-// zero-valued Name, it returns "".
-func (n Name) Common() string { return n.text }
+// Common returns the common (as opposed to file-as) form of a name, eg.,
+// "Dr. P. C. Hodgell, Ph.D." for a Name carrying a prefix and a post-nominal.
+// For a zero-valued Name, it returns "".
+func (n Name) Common() string { return n.commonWith(false, false) }
 
 // FileAs returns the ‘file-as form’ of a name (eg., "Drake, David" rather than
-// "David Drake").
-func (n Name) FileAs() string {
+// "David Drake"), with any prefixes and post-nominals (see prefix.go) woven
+// in, eg. "Hodgell, Dr. P. C., Ph.D.".
+func (n Name) FileAs() string { return n.fileAsWith(false, false) }
+
+// fileAs computes the file-as form, taking any surname particle (see
+// particle.go) into account, but not any prefixes or post-nominals.
+// SortKey() uses this, so that it stays stable however FileAs() is decorated.
+func (n Name) fileAs() string {
+	family, rest := n.fileAsParts()
 	if n.boSurname == 0 {
-		return n.text
+		return family
+	}
+	return family + ", " + rest
+}
+
+// fileAsParts splits a name's file-as form into the part that comes before
+// the comma (the surname, with a non-dropping particle if any) and the part
+// that comes after it (the forename, a dropping particle if any, and the
+// generation).  For a zero-valued or one-part Name, it returns (n.text, "").
+func (n Name) fileAsParts() (family, rest string) {
+	if n.boSurname == 0 {
+		return n.text, ""
+	}
+	forename := n.text[:n.boSurname-1]
+	familyWord := n.text[n.boFamily:n.eoSurname]
+	if n.boFamily == n.boSurname || !n.dropPtcl {
+		// No particle, or a non-dropping particle: it stays with the family word.
+		family, rest = n.text[n.boSurname:n.eoSurname], forename
+	} else {
+		// A dropping particle moves to the end, after the forename.
+		particle := n.text[n.boSurname : n.boFamily-1]
+		family, rest = familyWord, forename+" "+particle
 	}
-	faName := n.text[n.boSurname:n.eoSurname] + ", " + n.text[:n.boSurname-1]
 	if n.eoSurname < uint16(len(n.text)) {
-		faName += " " + n.text[n.eoSurname+1:]
+		rest += " " + n.text[n.eoSurname+1:]
 	}
-	return faName
+	return family, rest
 }
 
-// Surname returns the main part of a name.  It returns an empty string if and
-// only if called on a zero-valued Name object.  Remember that surnames can
+// Surname returns the true family-name word (or words), ignoring any
+// dropping or non-dropping particle.  It returns an empty string if and only
+// if called on a zero-valued Name object.  Remember that surnames can
 // contain multiple words.
-func (n Name) Surname() string { return n.text[n.boSurname:n.eoSurname] }
+func (n Name) Surname() string { return n.text[n.boFamily:n.eoSurname] }
+
+// SortKey returns the canonical alphabetization form of a name, eg.,
+// "Beethoven, Ludwig van" for a dropping particle or "de Gaulle, Charles" for
+// a non-dropping one.  Unlike FileAs(), this is guaranteed to stay in this
+// form even if FileAs() is later extended to add other decorations, so it
+// stays safe to use as a sort key.
+func (n Name) SortKey() string { return n.fileAs() }
 
 // Forename returns the part of a person’s name that usually comes before the surname.
 // It returns an empty string for zero and one-part names.
@@ -241,40 +287,43 @@ func (n Name) NumParts() int {
 }
 
 // String implements the fmt.Stringer interface. It returns the same value as Common().
-func (n Name) String() string { return n.text }
+func (n Name) String() string { return n.Common() }
 
 /*=========================== RelatedName objects ============================*/
 
 // A RelatedName is a Name plus a 3-letter ‘relator’ code; all three letters will
 // be from a-z (no accents, never æ, þ etc).  (The U.S. Library of Congress has
 // a list of relator codes at https://www.loc.gov/marc/relators/relaterm.html.)
+// The only exception is a synthetic code produced by Work.CollapseSharedRoles()
+// (see work.go), which joins two codes together (eg., "edt+trl").
 type RelatedName struct {
 	Name
-	relCode [3]byte
+	relCode string
+	relTerm string // overrides the relatorTerms/customRelators lookup when non-empty
 }
 
 var reRelator = regexp.MustCompile(`^[a-z][a-z][a-z]$`)
 
 // NewRelatedName forms a RelatedName object by copying a Name object (which must not
-// be zero-valued) and a relator code (which must consist of 3 letters in a-z).
+// be zero-valued) and a relator code (which must be a code from the Library of
+// Congress's list of MARC relators or one added with RegisterRelator() — see
+// relators.go).
 func NewRelatedName(n Name, relatorCode string) (RelatedName, error) {
 	if !reRelator.MatchString(relatorCode) {
 		return RelatedName{}, &BadRelatorCode{n, relatorCode}
 	}
+	if !isKnownRelator(relatorCode) {
+		return RelatedName{}, &UnknownRelatorError{n, relatorCode}
+	}
 	if n.NumParts() == 0 {
 		return RelatedName{}, &BadName{relatorCode}
 	}
-	var code [3]byte
-	code[0], code[1], code[2] = relatorCode[0], relatorCode[1], relatorCode[2]
-	return RelatedName{Name: n, relCode: code}, nil
+	return RelatedName{Name: n, relCode: relatorCode}, nil
 }
 
-// Relator returns the three-letter relator code from a related name.
-func (rn RelatedName) Relator() string {
-	b := []byte("!!!")
-	b[0], b[1], b[2] = rn.relCode[0], rn.relCode[1], rn.relCode[2]
-	return string(b)
-}
+// Relator returns the relator code from a related name — ordinarily 3
+// letters, but see RelatedName's doc comment about synthetic codes.
+func (rn RelatedName) Relator() string { return rn.relCode }
 
 // String implements the fmt.Stringer interface.
 func (rn RelatedName) String() string { return rn.Name.text + " (" + rn.Relator() + ")" }
@@ -192,7 +192,7 @@ func expectBadRelocator(t *testing.T, relCode string) {
 	} else if e, ok := err.(*relname.BadRelatorCode); !ok {
 		t.Errorf(`NewRelatedName(Sarah, %q) → wierd error %#v`,
 			relCode, err)
-	} else if *e != (relname.BadRelatorCode{Sarah, relCode}) {
+	} else if e.N.String() != Sarah.String() || e.C != relCode {
 		t.Errorf(`NewRelatedName(Sarah, %q) → error %#v\n\t%s %q)`,
 			relCode, err,
 			`right type but expected &BadRelatorCode{"Sarah A. Hoyt", `,
@@ -0,0 +1,133 @@
+// File:	ebooks/relname/work.go
+
+package relname
+
+import "strings"
+
+// This file adds Work, a bibliographic-entry type that groups the
+// RelatedNames for a single work (book, article, etc) and answers the
+// queries citation code usually needs of them.
+
+// A Work holds the ordered list of RelatedNames (authors, editors,
+// translators etc) credited on a single bibliographic entry.
+type Work struct {
+	Contributors []RelatedName
+
+	// EtAlThreshold, if greater than zero, makes FileAs() collapse to
+	// "FirstAuthor et al." once there are more authors than this.  Zero (the
+	// default) means always list every author.
+	EtAlThreshold int
+}
+
+// NewWork forms a Work from an ordered list of contributors.
+func NewWork(contributors ...RelatedName) Work {
+	return Work{Contributors: contributors}
+}
+
+// Authors returns the Names of all contributors whose relator code is "aut".
+func (w Work) Authors() []Name { return w.namesWithRelator("aut") }
+
+// Editors returns the Names of all contributors whose relator code is "edt"
+// (editor) or "edc" (editor of compilation).
+func (w Work) Editors() []Name {
+	var names []Name
+	for _, rn := range w.Contributors {
+		if code := rn.Relator(); code == "edt" || code == "edc" {
+			names = append(names, rn.Name)
+		}
+	}
+	return names
+}
+
+func (w Work) namesWithRelator(code string) []Name {
+	var names []Name
+	for _, rn := range w.Contributors {
+		if rn.Relator() == code {
+			names = append(names, rn.Name)
+		}
+	}
+	return names
+}
+
+// PrimaryContributor returns the first contributor on the Work, or the
+// zero-valued RelatedName if it has none.
+func (w Work) PrimaryContributor() RelatedName {
+	if len(w.Contributors) == 0 {
+		return RelatedName{}
+	}
+	return w.Contributors[0]
+}
+
+// FileAs returns the canonical multi-author sort key for a Work, eg.
+// "Niven, Larry; Pournelle, Jerry", or "Niven, Larry et al." once there are
+// more authors than w.EtAlThreshold.  It returns "" if the Work has no
+// authors.
+func (w Work) FileAs() string {
+	authors := w.Authors()
+	if len(authors) == 0 {
+		return ""
+	}
+	if w.EtAlThreshold > 0 && len(authors) > w.EtAlThreshold {
+		return authors[0].FileAs() + " et al."
+	}
+	fileAsForms := make([]string, len(authors))
+	for i, a := range authors {
+		fileAsForms[i] = a.FileAs()
+	}
+	return strings.Join(fileAsForms, "; ")
+}
+
+// A RelatorPair tells CollapseSharedRoles() to fold a contributor credited
+// under both CodeA and CodeB into one combined RelatedName, with the
+// synthetic relator code CombinedCode and the English term CombinedTerm
+// (eg., {"edt", "trl", "edt+trl", "edited and translated by"}).
+type RelatorPair struct {
+	CodeA, CodeB               string
+	CombinedCode, CombinedTerm string
+}
+
+// CollapseSharedRoles returns a copy of w in which, for each given
+// RelatorPair, any contributor who is the same person (by Common() form)
+// under both of that pair's roles is folded into a single combined
+// RelatedName — the equivalent of the editor/translator collapsing that CSL
+// name evaluation does for a work edited and translated by the same person.
+func (w Work) CollapseSharedRoles(pairs ...RelatorPair) Work {
+	contributors := append([]RelatedName(nil), w.Contributors...)
+	for _, pair := range pairs {
+		contributors = collapseRolePair(contributors, pair)
+	}
+	return Work{Contributors: contributors, EtAlThreshold: w.EtAlThreshold}
+}
+
+func collapseRolePair(contributors []RelatedName, pair RelatorPair) []RelatedName {
+	usedB := make([]bool, len(contributors))
+	combined := make(map[int]RelatedName)
+	for i, a := range contributors {
+		if a.Relator() != pair.CodeA {
+			continue
+		}
+		for j, b := range contributors {
+			if i == j || usedB[j] || b.Relator() != pair.CodeB {
+				continue
+			}
+			if b.Common() == a.Common() {
+				usedB[j] = true
+				combined[i] = RelatedName{
+					Name: a.Name, relCode: pair.CombinedCode, relTerm: pair.CombinedTerm}
+				break
+			}
+		}
+	}
+	result := make([]RelatedName, 0, len(contributors))
+	for i, rn := range contributors {
+		if usedB[i] {
+			continue
+		}
+		if c, ok := combined[i]; ok {
+			result = append(result, c)
+			continue
+		}
+		result = append(result, rn)
+	}
+	return result
+}
@@ -0,0 +1,97 @@
+package relname_test
+
+import (
+	"github.com/c12h/relname"
+	"testing"
+)
+
+/*============================== Testing Work =================================*/
+
+func TestWorkAuthorsAndEditors(t *testing.T) {
+	niven, _ := relname.NewName2("Larry", "Niven")
+	pournelle, _ := relname.NewName2("Jerry", "Pournelle")
+	editor, _ := relname.NewName2("Some", "Editor")
+
+	autNiven, err := relname.NewRelatedName(niven, "aut")
+	check(t, err, `NewRelatedName(niven, "aut")`)
+	autPournelle, err := relname.NewRelatedName(pournelle, "aut")
+	check(t, err, `NewRelatedName(pournelle, "aut")`)
+	edtEditor, err := relname.NewRelatedName(editor, "edt")
+	check(t, err, `NewRelatedName(editor, "edt")`)
+
+	w := relname.NewWork(autNiven, autPournelle, edtEditor)
+
+	authors := w.Authors()
+	if len(authors) != 2 || authors[0].Common() != "Larry Niven" || authors[1].Common() != "Jerry Pournelle" {
+		t.Errorf("w.Authors() == %v, expected [Larry Niven, Jerry Pournelle]", authors)
+	}
+	editors := w.Editors()
+	if len(editors) != 1 || editors[0].Common() != "Some Editor" {
+		t.Errorf("w.Editors() == %v, expected [Some Editor]", editors)
+	}
+	if pc := w.PrimaryContributor(); pc.Common() != "Larry Niven" {
+		t.Errorf("w.PrimaryContributor().Common() == %q, expected %q", pc.Common(), "Larry Niven")
+	}
+
+	expected := "Niven, Larry; Pournelle, Jerry"
+	if fa := w.FileAs(); fa != expected {
+		t.Errorf("w.FileAs() == %q, expected %q", fa, expected)
+	}
+
+	w.EtAlThreshold = 1
+	if fa := w.FileAs(); fa != "Niven, Larry et al." {
+		t.Errorf("w.FileAs() with EtAlThreshold=1 == %q, expected %q", fa, "Niven, Larry et al.")
+	}
+}
+
+func TestWorkFileAsNoAuthors(t *testing.T) {
+	editor, _ := relname.NewName2("Some", "Editor")
+	edtEditor, err := relname.NewRelatedName(editor, "edt")
+	check(t, err, `NewRelatedName(editor, "edt")`)
+	w := relname.NewWork(edtEditor)
+	if fa := w.FileAs(); fa != "" {
+		t.Errorf(`w.FileAs() with no authors == %q, expected ""`, fa)
+	}
+}
+
+func TestWorkCollapseSharedRoles(t *testing.T) {
+	hoyt, _ := relname.NewName2("Sarah", "Hoyt")
+	other, _ := relname.NewName2("Some", "Translator")
+
+	edtHoyt, err := relname.NewRelatedName(hoyt, "edt")
+	check(t, err, `NewRelatedName(hoyt, "edt")`)
+	trlHoyt, err := relname.NewRelatedName(hoyt, "trl")
+	check(t, err, `NewRelatedName(hoyt, "trl")`)
+	trlOther, err := relname.NewRelatedName(other, "trl")
+	check(t, err, `NewRelatedName(other, "trl")`)
+
+	w := relname.NewWork(edtHoyt, trlHoyt, trlOther)
+	collapsed := w.CollapseSharedRoles(relname.RelatorPair{
+		CodeA: "edt", CodeB: "trl",
+		CombinedCode: "edt+trl", CombinedTerm: "edited and translated by",
+	})
+
+	if len(collapsed.Contributors) != 2 {
+		t.Fatalf("collapsed.Contributors has %d entries, expected 2", len(collapsed.Contributors))
+	}
+	var found bool
+	for _, rn := range collapsed.Contributors {
+		if rn.Common() == "Sarah Hoyt" {
+			found = true
+			if rn.Relator() != "edt+trl" {
+				t.Errorf(`collapsed Sarah Hoyt.Relator() == %q, expected "edt+trl"`, rn.Relator())
+			}
+			if term := rn.RelatorTerm(); term != "edited and translated by" {
+				t.Errorf(`collapsed Sarah Hoyt.RelatorTerm() == %q, expected "edited and translated by"`, term)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("collapsed.Contributors does not contain a combined entry for Sarah Hoyt")
+	}
+	// The un-shared translator should be untouched.
+	if collapsed.Contributors[len(collapsed.Contributors)-1].Common() != "Some Translator" &&
+		collapsed.Contributors[0].Common() != "Some Translator" {
+		t.Errorf("collapsed.Contributors lost the un-shared translator: %v", collapsed.Contributors)
+	}
+}